@@ -0,0 +1,171 @@
+package lunar
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DataSource supplies the raw per-year HKO calendar text file
+// ("T{year}c.txt") that Handler parses. Implementations may read from
+// disk, an embedded FS, a remote mirror, or anything else that can
+// produce an io.ReadCloser for a given year.
+type DataSource interface {
+	Open(year int) (io.ReadCloser, error)
+}
+
+// Option configures a Handler built by New.
+type Option func(*Handler)
+
+// WithDataSource overrides the Handler's DataSource. Without this
+// option, New uses EmbeddedDataSource.
+func WithDataSource(ds DataSource) Option {
+	return func(h *Handler) {
+		h.dataSource = ds
+	}
+}
+
+//go:embed files
+var embeddedFiles embed.FS
+
+// EmbeddedDataSource returns a DataSource backed by the "T{year}c.txt"
+// files embedded from ./files at build time, covering 1901-2100 when
+// populated per the fetch recipe at the top of lunar.go. It is the
+// default DataSource for New, so importing this package is enough to
+// start looking up dates with zero configuration.
+func EmbeddedDataSource() DataSource {
+	sub, err := fs.Sub(embeddedFiles, "files")
+	if err != nil {
+		// embeddedFiles is produced by go:embed from a literal "files"
+		// directory, so this can't fail at runtime.
+		panic(err)
+	}
+
+	return FSDataSource(sub)
+}
+
+// FSDataSource returns a DataSource that reads "T{year}c.txt" out of
+// fsys, for callers who want to supply their own io/fs tree (an
+// embed.FS, os.DirFS, a zip archive, ...).
+func FSDataSource(fsys fs.FS) DataSource {
+	return fsDataSource{fsys: fsys}
+}
+
+type fsDataSource struct {
+	fsys fs.FS
+}
+
+func (s fsDataSource) Open(year int) (io.ReadCloser, error) {
+	return s.fsys.Open(fmt.Sprintf("T%dc.txt", year))
+}
+
+// HTTPDataSourceOption configures an HTTPDataSource.
+type HTTPDataSourceOption func(*httpDataSource)
+
+// WithCacheDir makes an HTTPDataSource persist fetched year files under
+// dir, so repeated lookups don't re-download the same year.
+func WithCacheDir(dir string) HTTPDataSourceOption {
+	return func(s *httpDataSource) {
+		s.cacheDir = dir
+	}
+}
+
+// HTTPDataSource returns a DataSource that lazily fetches
+// "T{year}c.txt" from baseURL (e.g. the HKO mirror documented at the
+// top of lunar.go), trading binary size for network access. Pair with
+// WithCacheDir to avoid re-fetching the same year on every process
+// start.
+func HTTPDataSource(baseURL string, opts ...HTTPDataSourceOption) DataSource {
+	s := &httpDataSource{baseURL: strings.TrimRight(baseURL, "/")}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+type httpDataSource struct {
+	baseURL  string
+	cacheDir string
+}
+
+func (s *httpDataSource) Open(year int) (io.ReadCloser, error) {
+	name := fmt.Sprintf("T%dc.txt", year)
+
+	if s.cacheDir != "" {
+		if f, err := os.Open(filepath.Join(s.cacheDir, name)); err == nil {
+			return f, nil
+		}
+	}
+
+	resp, err := http.Get(s.baseURL + "/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("lunar: fetch %s: %w", name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("lunar: fetch %s: %s", name, resp.Status)
+	}
+
+	if s.cacheDir == "" {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	return s.cacheResponse(name, resp.Body)
+}
+
+func (s *httpDataSource) cacheResponse(name string, body io.Reader) (io.ReadCloser, error) {
+	if err := os.MkdirAll(s.cacheDir, 0o755); err != nil {
+		return nil, fmt.Errorf("lunar: cache %s: %w", name, err)
+	}
+
+	tmp, err := os.CreateTemp(s.cacheDir, name+".*")
+	if err != nil {
+		return nil, fmt.Errorf("lunar: cache %s: %w", name, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("lunar: cache %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("lunar: cache %s: %w", name, err)
+	}
+
+	path := filepath.Join(s.cacheDir, name)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, fmt.Errorf("lunar: cache %s: %w", name, err)
+	}
+
+	return os.Open(path)
+}
+
+// Preload eagerly loads and caches each of years, so later lookups
+// within them skip the DataSource entirely. Long-running services call
+// this at startup to pay the I/O cost once.
+func (h *Handler) Preload(years ...int) error {
+	for _, year := range years {
+		if _, err := h.DateToLunarDate(NewDate(year, 1, 1)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EvictBefore drops cached years strictly before year, bounding memory
+// for long-running services that no longer need old years.
+func (h *Handler) EvictBefore(year int) {
+	for y := range h.cacheMap {
+		if y < year {
+			delete(h.cacheMap, y)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package lunar_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	lunar "github.com/lesywix/lunar"
+)
+
+func TestFSDataSourceOpensNamedYearFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"T2024c.txt": {Data: []byte(holidayFixtureYear(2024, 1, nil))},
+	}
+
+	ds := lunar.FSDataSource(fsys)
+
+	f, err := ds.Open(2024)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if !strings.Contains(string(data), "header") {
+		t.Fatalf("Open(2024) content = %q, want the fixture header", data)
+	}
+
+	if _, err := ds.Open(2025); err == nil {
+		t.Fatal("Open(2025) = nil error, want an error for a missing file")
+	}
+}
+
+// countingDataSource wraps a fixed set of year files and counts how
+// many times each year is opened, so tests can assert on cache
+// behavior (Preload, EvictBefore) without depending on timing.
+type countingDataSource struct {
+	mu    sync.Mutex
+	files map[int]string
+	opens map[int]int
+}
+
+func (c *countingDataSource) Open(year int) (io.ReadCloser, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	content, ok := c.files[year]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	if c.opens == nil {
+		c.opens = map[int]int{}
+	}
+	c.opens[year]++
+
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+func (c *countingDataSource) openCount(year int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opens[year]
+}
+
+func TestPreloadCachesSoLaterLookupsDontReopen(t *testing.T) {
+	ds := &countingDataSource{files: map[int]string{
+		2024: holidayFixtureYear(2024, 366, nil),
+	}}
+	h := lunar.New(lunar.WithDataSource(ds))
+
+	if err := h.Preload(2024); err != nil {
+		t.Fatalf("Preload: %v", err)
+	}
+	if got := ds.openCount(2024); got != 1 {
+		t.Fatalf("openCount(2024) after Preload = %d, want 1", got)
+	}
+
+	if _, err := h.DateToLunarDate(lunar.NewDate(2024, 6, 1)); err != nil {
+		t.Fatalf("DateToLunarDate: %v", err)
+	}
+	if got := ds.openCount(2024); got != 1 {
+		t.Fatalf("openCount(2024) after a preloaded lookup = %d, want still 1", got)
+	}
+}
+
+func TestEvictBeforeDropsOldYears(t *testing.T) {
+	ds := &countingDataSource{files: map[int]string{
+		2023: holidayFixtureYear(2023, 366, nil),
+		2024: holidayFixtureYear(2024, 366, nil),
+	}}
+	h := lunar.New(lunar.WithDataSource(ds))
+
+	if err := h.Preload(2023, 2024); err != nil {
+		t.Fatalf("Preload: %v", err)
+	}
+
+	h.EvictBefore(2024)
+
+	if _, err := h.DateToLunarDate(lunar.NewDate(2024, 6, 1)); err != nil {
+		t.Fatalf("DateToLunarDate(2024): %v", err)
+	}
+	if got := ds.openCount(2024); got != 1 {
+		t.Fatalf("openCount(2024) after EvictBefore(2024) = %d, want still 1 (2024 wasn't evicted)", got)
+	}
+
+	if _, err := h.DateToLunarDate(lunar.NewDate(2023, 6, 1)); err != nil {
+		t.Fatalf("DateToLunarDate(2023): %v", err)
+	}
+	if got := ds.openCount(2023); got != 2 {
+		t.Fatalf("openCount(2023) after EvictBefore(2024) = %d, want 2 (2023 was evicted and re-opened)", got)
+	}
+}
+
+func TestHTTPDataSourceCachesAfterFirstFetch(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(holidayFixtureYear(2024, 1, nil)))
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+	ds := lunar.HTTPDataSource(srv.URL, lunar.WithCacheDir(cacheDir))
+
+	f, err := ds.Open(2024)
+	if err != nil {
+		t.Fatalf("Open (first fetch): %v", err)
+	}
+	f.Close()
+
+	if _, err := os.Stat(filepath.Join(cacheDir, "T2024c.txt")); err != nil {
+		t.Fatalf("cache file not written: %v", err)
+	}
+
+	f2, err := ds.Open(2024)
+	if err != nil {
+		t.Fatalf("Open (cached): %v", err)
+	}
+	f2.Close()
+
+	if requests != 1 {
+		t.Fatalf("server received %d requests, want 1 (second Open should hit the cache)", requests)
+	}
+}
@@ -0,0 +1,127 @@
+package lunar
+
+import "strings"
+
+// Placeholders recognized by Date.FormatLunar and Result.Format. Any other
+// text in the layout passes through unchanged, the same way Go's own
+// time layout tokens do.
+const (
+	LayoutGanzhiYear = "干支"
+	LayoutZodiac     = "生肖"
+	LayoutLunarMonth = "農曆月"
+	LayoutLunarDay   = "農曆日"
+	LayoutSolarTerm  = "節氣"
+)
+
+// Localizer supplies the textual form of the lunar calendar components
+// used by FormatLunar and Result.Format, so that alternative locales
+// (simplified Chinese, Vietnamese, Korean, ...) can plug in instead of
+// the traditional-Chinese names used by DefaultLocalizer.
+type Localizer interface {
+	// GanzhiYear renders the sexagenary-cycle name for a lunar year,
+	// e.g. "甲子年".
+	GanzhiYear(year int) string
+	// Zodiac renders the zodiac animal for a lunar year, e.g. "鼠".
+	Zodiac(year int) string
+	// LunarMonth renders a lunar month, e.g. "正月" or, when leap is
+	// true, "閏四月".
+	LunarMonth(month int, leap bool) string
+	// LunarDay renders a lunar day, e.g. "初一", "十五", "廿三" or "三十".
+	LunarDay(day int) string
+}
+
+// DefaultLocalizer renders the traditional-Chinese names used throughout
+// this package's parser, so that parsing and formatting stay symmetric.
+var DefaultLocalizer Localizer = zhHantLocalizer{}
+
+type zhHantLocalizer struct{}
+
+var (
+	ganzhiStems    = []rune("甲乙丙丁戊己庚辛壬癸")
+	ganzhiBranches = []rune("子丑寅卯辰巳午未申酉戌亥")
+	zodiacAnimals  = []rune("鼠牛虎兔龍蛇馬羊猴雞狗豬")
+	lunarMonthName = []string{"", "正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "十一", "十二"}
+	lunarDayDigit  = []string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+)
+
+func (zhHantLocalizer) GanzhiYear(year int) string {
+	stem := ganzhiStems[((year-4)%10+10)%10]
+	branch := ganzhiBranches[((year-4)%12+12)%12]
+	return string(stem) + string(branch) + "年"
+}
+
+func (zhHantLocalizer) Zodiac(year int) string {
+	return string(zodiacAnimals[((year-4)%12+12)%12])
+}
+
+func (zhHantLocalizer) LunarMonth(month int, leap bool) string {
+	if month < 1 || month > 12 {
+		return ""
+	}
+
+	name := lunarMonthName[month] + "月"
+	if leap {
+		name = "閏" + name
+	}
+	return name
+}
+
+func (zhHantLocalizer) LunarDay(day int) string {
+	switch {
+	case day == 10:
+		return "初十"
+	case day == 20:
+		return "二十"
+	case day == 30:
+		return "三十"
+	case day > 0 && day < 10:
+		return "初" + lunarDayDigit[day]
+	case day > 10 && day < 20:
+		return "十" + lunarDayDigit[day-10]
+	case day > 20 && day < 30:
+		return "廿" + lunarDayDigit[day-20]
+	default:
+		return ""
+	}
+}
+
+// FormatLunar renders d, interpreted as a lunar date (Year/Month/Day/Leap),
+// using DefaultLocalizer. layout may contain any of LayoutGanzhiYear,
+// LayoutZodiac, LayoutLunarMonth and LayoutLunarDay; LayoutSolarTerm
+// resolves to the empty string here since a bare Date carries no solar
+// term — use Result.Format for that.
+func (d Date) FormatLunar(layout string) string {
+	return d.FormatLunarWithLocalizer(layout, DefaultLocalizer)
+}
+
+// FormatLunarWithLocalizer is like FormatLunar but renders lunar
+// components using loc instead of DefaultLocalizer.
+func (d Date) FormatLunarWithLocalizer(layout string, loc Localizer) string {
+	return d.formatLunar(layout, loc, "")
+}
+
+func (d Date) formatLunar(layout string, loc Localizer, solarTerm string) string {
+	replacer := strings.NewReplacer(
+		LayoutGanzhiYear, loc.GanzhiYear(d.Year),
+		LayoutZodiac, loc.Zodiac(d.Year),
+		LayoutLunarMonth, loc.LunarMonth(d.Month, d.Leap),
+		LayoutLunarDay, loc.LunarDay(d.Day),
+		LayoutSolarTerm, solarTerm,
+	)
+	return replacer.Replace(layout)
+}
+
+// Format renders r using a layout that may mix standard Go time layout
+// tokens (applied to the Gregorian Date) with the lunar placeholders
+// recognized by Date.FormatLunar (applied to LunarDate, with
+// LayoutSolarTerm filled in from r.SolarTerm).
+func (r *Result) Format(layout string) string {
+	return r.FormatWithLocalizer(layout, DefaultLocalizer)
+}
+
+// FormatWithLocalizer is like Format but renders lunar components using
+// loc instead of DefaultLocalizer.
+func (r *Result) FormatWithLocalizer(layout string, loc Localizer) string {
+	layout = r.LunarDate.formatLunar(layout, loc, r.SolarTerm)
+	return r.Date.Time().Format(layout)
+}
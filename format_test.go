@@ -0,0 +1,79 @@
+package lunar_test
+
+import (
+	"testing"
+
+	lunar "github.com/lesywix/lunar"
+)
+
+func TestDefaultLocalizerGanzhiYearAndZodiac(t *testing.T) {
+	if got := lunar.DefaultLocalizer.GanzhiYear(1984); got != "甲子年" {
+		t.Errorf("GanzhiYear(1984) = %q, want 甲子年", got)
+	}
+	if got := lunar.DefaultLocalizer.Zodiac(1984); got != "鼠" {
+		t.Errorf("Zodiac(1984) = %q, want 鼠", got)
+	}
+
+	// The sexagenary cycle repeats every 60 years.
+	if got := lunar.DefaultLocalizer.GanzhiYear(2044); got != "甲子年" {
+		t.Errorf("GanzhiYear(2044) = %q, want 甲子年", got)
+	}
+}
+
+func TestDefaultLocalizerLunarMonthAndDay(t *testing.T) {
+	cases := []struct {
+		month int
+		leap  bool
+		want  string
+	}{
+		{1, false, "正月"},
+		{4, true, "閏四月"},
+		{12, false, "十二月"},
+	}
+	for _, c := range cases {
+		if got := lunar.DefaultLocalizer.LunarMonth(c.month, c.leap); got != c.want {
+			t.Errorf("LunarMonth(%d, %v) = %q, want %q", c.month, c.leap, got, c.want)
+		}
+	}
+
+	dayCases := []struct {
+		day  int
+		want string
+	}{
+		{1, "初一"},
+		{10, "初十"},
+		{15, "十五"},
+		{20, "二十"},
+		{23, "廿三"},
+		{30, "三十"},
+	}
+	for _, c := range dayCases {
+		if got := lunar.DefaultLocalizer.LunarDay(c.day); got != c.want {
+			t.Errorf("LunarDay(%d) = %q, want %q", c.day, got, c.want)
+		}
+	}
+}
+
+func TestDateFormatLunar(t *testing.T) {
+	d := lunar.Date{Year: 1984, Month: 4, Day: 15, Leap: true}
+
+	got := d.FormatLunar("干支 生肖年 農曆月農曆日")
+	want := "甲子年 鼠年 閏四月十五"
+	if got != want {
+		t.Fatalf("FormatLunar = %q, want %q", got, want)
+	}
+}
+
+func TestResultFormatMixesGregorianAndLunarTokens(t *testing.T) {
+	r := &lunar.Result{
+		Date:      lunar.NewDate(2024, 2, 10),
+		LunarDate: lunar.Date{Year: 2024, Month: 1, Day: 1, Leap: false},
+		SolarTerm: "立春",
+	}
+
+	got := r.Format("2006-01-02 農曆月農曆日 節氣")
+	want := "2024-02-10 正月初一 立春"
+	if got != want {
+		t.Fatalf("Format = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,237 @@
+package lunar
+
+import "sort"
+
+// Holiday describes a single resolved holiday occurrence for a given
+// year, as returned by Holidays.
+type Holiday struct {
+	Name      string
+	NameEn    string
+	Date      Date
+	LunarDate Date
+	SolarTerm string
+	Region    string
+}
+
+// HolidayRuleKind selects how a HolidayRule is anchored to the calendar.
+type HolidayRuleKind int
+
+const (
+	// HolidayRuleLunarFixed anchors the holiday to a fixed lunar
+	// month/day, or to the last day of the lunar year when
+	// LastDayOfLunarYear is set (e.g. 除夕).
+	HolidayRuleLunarFixed HolidayRuleKind = iota
+	// HolidayRuleSolarTerm anchors the holiday to a named solar term
+	// as produced by Handler.GetSolarTerms (e.g. 清明, 冬至).
+	HolidayRuleSolarTerm
+	// HolidayRuleGregorianFixed anchors the holiday to a fixed
+	// Gregorian month/day (e.g. 元旦, 國慶).
+	HolidayRuleGregorianFixed
+)
+
+// HolidayRule is a data-driven description of a recurring holiday.
+// Register additional rules with RegisterHoliday.
+type HolidayRule struct {
+	Name   string
+	NameEn string
+	Region string
+	Kind   HolidayRuleKind
+
+	// Used when Kind == HolidayRuleLunarFixed.
+	LunarMonth         int
+	LunarDay           int
+	LastDayOfLunarYear bool
+
+	// Used when Kind == HolidayRuleSolarTerm.
+	SolarTermName string
+
+	// Used when Kind == HolidayRuleGregorianFixed.
+	GregorianMonth int
+	GregorianDay   int
+}
+
+var holidayRulesByRegion = map[string][]HolidayRule{}
+
+// RegisterHoliday adds rule to the rule table for rule.Region. It is safe
+// to call from init() to register custom regions or to extend the
+// built-in "CN", "HK" and "TW" bundles.
+func RegisterHoliday(rule HolidayRule) {
+	holidayRulesByRegion[rule.Region] = append(holidayRulesByRegion[rule.Region], rule)
+}
+
+func init() {
+	for _, rule := range cnHolidayRules {
+		RegisterHoliday(rule)
+	}
+	for _, rule := range hkHolidayRules {
+		RegisterHoliday(rule)
+	}
+	for _, rule := range twHolidayRules {
+		RegisterHoliday(rule)
+	}
+}
+
+var cnHolidayRules = []HolidayRule{
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "春節", NameEn: "Spring Festival", LunarMonth: 1, LunarDay: 1},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "元宵", NameEn: "Lantern Festival", LunarMonth: 1, LunarDay: 15},
+	{Region: "CN", Kind: HolidayRuleSolarTerm, Name: "清明", NameEn: "Qingming Festival", SolarTermName: "清明"},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "端午", NameEn: "Dragon Boat Festival", LunarMonth: 5, LunarDay: 5},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "七夕", NameEn: "Qixi Festival", LunarMonth: 7, LunarDay: 7},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "中元", NameEn: "Ghost Festival", LunarMonth: 7, LunarDay: 15},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "中秋", NameEn: "Mid-Autumn Festival", LunarMonth: 8, LunarDay: 15},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "重陽", NameEn: "Double Ninth Festival", LunarMonth: 9, LunarDay: 9},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "臘八", NameEn: "Laba Festival", LunarMonth: 12, LunarDay: 8},
+	{Region: "CN", Kind: HolidayRuleLunarFixed, Name: "除夕", NameEn: "Chinese New Year's Eve", LastDayOfLunarYear: true},
+	{Region: "CN", Kind: HolidayRuleSolarTerm, Name: "冬至", NameEn: "Winter Solstice", SolarTermName: "冬至"},
+	{Region: "CN", Kind: HolidayRuleGregorianFixed, Name: "元旦", NameEn: "New Year's Day", GregorianMonth: 1, GregorianDay: 1},
+	{Region: "CN", Kind: HolidayRuleGregorianFixed, Name: "國慶", NameEn: "National Day", GregorianMonth: 10, GregorianDay: 1},
+}
+
+var hkHolidayRules = []HolidayRule{
+	{Region: "HK", Kind: HolidayRuleLunarFixed, Name: "春節", NameEn: "Lunar New Year", LunarMonth: 1, LunarDay: 1},
+	{Region: "HK", Kind: HolidayRuleSolarTerm, Name: "清明", NameEn: "Ching Ming Festival", SolarTermName: "清明"},
+	{Region: "HK", Kind: HolidayRuleLunarFixed, Name: "端午", NameEn: "Tuen Ng Festival", LunarMonth: 5, LunarDay: 5},
+	{Region: "HK", Kind: HolidayRuleLunarFixed, Name: "中秋", NameEn: "Mid-Autumn Festival", LunarMonth: 8, LunarDay: 15},
+	{Region: "HK", Kind: HolidayRuleLunarFixed, Name: "重陽", NameEn: "Chung Yeung Festival", LunarMonth: 9, LunarDay: 9},
+	{Region: "HK", Kind: HolidayRuleLunarFixed, Name: "除夕", NameEn: "Lunar New Year's Eve", LastDayOfLunarYear: true},
+	{Region: "HK", Kind: HolidayRuleGregorianFixed, Name: "元旦", NameEn: "New Year's Day", GregorianMonth: 1, GregorianDay: 1},
+	{Region: "HK", Kind: HolidayRuleGregorianFixed, Name: "國慶", NameEn: "National Day", GregorianMonth: 10, GregorianDay: 1},
+}
+
+var twHolidayRules = []HolidayRule{
+	{Region: "TW", Kind: HolidayRuleLunarFixed, Name: "春節", NameEn: "Lunar New Year", LunarMonth: 1, LunarDay: 1},
+	{Region: "TW", Kind: HolidayRuleSolarTerm, Name: "清明", NameEn: "Tomb Sweeping Day", SolarTermName: "清明"},
+	{Region: "TW", Kind: HolidayRuleLunarFixed, Name: "端午", NameEn: "Dragon Boat Festival", LunarMonth: 5, LunarDay: 5},
+	{Region: "TW", Kind: HolidayRuleLunarFixed, Name: "中秋", NameEn: "Mid-Autumn Festival", LunarMonth: 8, LunarDay: 15},
+	{Region: "TW", Kind: HolidayRuleLunarFixed, Name: "除夕", NameEn: "Lunar New Year's Eve", LastDayOfLunarYear: true},
+	{Region: "TW", Kind: HolidayRuleGregorianFixed, Name: "元旦", NameEn: "New Year's Day", GregorianMonth: 1, GregorianDay: 1},
+	{Region: "TW", Kind: HolidayRuleGregorianFixed, Name: "國慶日", NameEn: "Double Ten Day", GregorianMonth: 10, GregorianDay: 10},
+}
+
+// Holidays returns every holiday resolved for region for the given
+// year, sorted chronologically. Lunar-fixed rules resolve in lunar year
+// `year`, so 除夕 (and usually 臘八) falls in January or February of
+// Gregorian year+1; Gregorian-fixed rules fall in Gregorian year `year`.
+func Holidays(year int, region string) ([]*Holiday, error) {
+	return defaultHandler.Holidays(year, region)
+}
+
+func (h *Handler) Holidays(year int, region string) ([]*Holiday, error) {
+	rules := holidayRulesByRegion[region]
+	holidays := make([]*Holiday, 0, len(rules))
+	for _, rule := range rules {
+		holiday, err := h.resolveHoliday(year, rule)
+		if err != nil {
+			return nil, err
+		}
+		if holiday != nil {
+			holidays = append(holidays, holiday)
+		}
+	}
+
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date.Time().Before(holidays[j].Date.Time())
+	})
+
+	return holidays, nil
+}
+
+// IsHoliday reports whether d is a "CN" region holiday, returning the
+// matching Holiday when it is.
+func IsHoliday(d Date) (*Holiday, bool, error) {
+	return defaultHandler.IsHoliday(d)
+}
+
+func (h *Handler) IsHoliday(d Date) (*Holiday, bool, error) {
+	return h.IsHolidayIn(d, "CN")
+}
+
+// IsHolidayIn reports whether d is a holiday for region, returning the
+// matching Holiday when it is.
+func (h *Handler) IsHolidayIn(d Date, region string) (*Holiday, bool, error) {
+	holidays, err := h.Holidays(d.Year, region)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, holiday := range holidays {
+		if holiday.Date.Equal(d) {
+			return holiday, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (h *Handler) resolveHoliday(year int, rule HolidayRule) (*Holiday, error) {
+	switch rule.Kind {
+	case HolidayRuleLunarFixed:
+		return h.resolveLunarFixedHoliday(year, rule)
+	case HolidayRuleSolarTerm:
+		return h.resolveSolarTermHoliday(year, rule)
+	case HolidayRuleGregorianFixed:
+		return h.resolveGregorianFixedHoliday(year, rule)
+	default:
+		return nil, nil
+	}
+}
+
+func (h *Handler) resolveLunarFixedHoliday(year int, rule HolidayRule) (*Holiday, error) {
+	lunarDate := NewDate(year, rule.LunarMonth, rule.LunarDay)
+	if rule.LastDayOfLunarYear {
+		newYear, err := h.LunarDateToDate(NewDate(year+1, 1, 1))
+		if err == ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		eve := DateByTime(newYear.Date.Time().AddDate(0, 0, -1))
+		res, err := h.DateToLunarDate(eve)
+		if err == ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &Holiday{Name: rule.Name, NameEn: rule.NameEn, Date: res.Date, LunarDate: res.LunarDate, Region: rule.Region}, nil
+	}
+
+	res, err := h.LunarDateToDate(lunarDate)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Holiday{Name: rule.Name, NameEn: rule.NameEn, Date: res.Date, LunarDate: res.LunarDate, Region: rule.Region}, nil
+}
+
+func (h *Handler) resolveSolarTermHoliday(year int, rule HolidayRule) (*Holiday, error) {
+	terms, err := h.GetSolarTerms(year, rule.SolarTermName)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	r := terms[0]
+	return &Holiday{Name: rule.Name, NameEn: rule.NameEn, Date: r.Date, LunarDate: r.LunarDate, SolarTerm: r.SolarTerm, Region: rule.Region}, nil
+}
+
+func (h *Handler) resolveGregorianFixedHoliday(year int, rule HolidayRule) (*Holiday, error) {
+	d := NewDate(year, rule.GregorianMonth, rule.GregorianDay)
+	res, err := h.DateToLunarDate(d)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Holiday{Name: rule.Name, NameEn: rule.NameEn, Date: d, LunarDate: res.LunarDate, Region: rule.Region}, nil
+}
@@ -0,0 +1,178 @@
+package lunar_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	lunar "github.com/lesywix/lunar"
+)
+
+// holidayFixtureDataSource serves a single synthetic T{year}c.txt per
+// year, enough to resolve the lunar-fixed, solar-term and
+// Gregorian-fixed holiday rules exercised below.
+type holidayFixtureDataSource map[int]string
+
+func (f holidayFixtureDataSource) Open(year int) (io.ReadCloser, error) {
+	content, ok := f[year]
+	if !ok {
+		return nil, fmt.Errorf("lunar_test: no fixture for year %d", year)
+	}
+
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+// holidayFixtureYear builds a minimal T{year}c.txt covering Jan 1
+// through the given number of days, assigning lunar month/day text
+// sequentially from 正月初一 and stamping solar terms at the given
+// Gregorian dates (keyed "2006-01-02").
+func holidayFixtureYear(year, days int, terms map[string]string) string {
+	var b strings.Builder
+	b.WriteString("header\nheader\nheader\n")
+
+	lunarMonth, lunarDay := 1, 1
+	date := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < days; i++ {
+		var lunarField string
+		if lunarDay == 1 {
+			lunarField = lunar.DefaultLocalizer.LunarMonth(lunarMonth, false)
+		} else {
+			lunarField = lunar.DefaultLocalizer.LunarDay(lunarDay)
+		}
+
+		fmt.Fprintf(&b, "%s %s 星期%s", date.Format("2006年1月2日"), lunarField, "一")
+		if term, ok := terms[date.Format("2006-01-02")]; ok {
+			fmt.Fprintf(&b, " %s", term)
+		}
+		b.WriteString("\n")
+
+		date = date.AddDate(0, 0, 1)
+		lunarDay++
+		if lunarDay > 30 {
+			lunarDay = 1
+			lunarMonth++
+			if lunarMonth > 12 {
+				lunarMonth = 1
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func TestHolidaysResolvesLunarFixedAndGregorianFixed(t *testing.T) {
+	ds := holidayFixtureDataSource{
+		2024: holidayFixtureYear(2024, 366, map[string]string{}),
+		2025: holidayFixtureYearBeforeCNY(2025, 10),
+		2026: holidayFixtureYearBeforeCNY(2026, 10),
+	}
+	h := lunar.New(lunar.WithDataSource(ds))
+
+	holidays, err := h.Holidays(2024, "CN")
+	if err != nil {
+		t.Fatalf("Holidays: %v", err)
+	}
+
+	var sawSpringFestival, sawNewYearsDay bool
+	for _, hol := range holidays {
+		switch hol.Name {
+		case "春節":
+			sawSpringFestival = true
+			if !hol.Date.Equal(lunar.NewDate(2024, 1, 1)) {
+				t.Errorf("春節 date = %s, want 2024-01-01", hol.Date)
+			}
+		case "元旦":
+			sawNewYearsDay = true
+			if !hol.Date.Equal(lunar.NewDate(2024, 1, 1)) {
+				t.Errorf("元旦 date = %s, want 2024-01-01", hol.Date)
+			}
+		}
+	}
+
+	if !sawSpringFestival {
+		t.Error("Holidays did not resolve 春節")
+	}
+	if !sawNewYearsDay {
+		t.Error("Holidays did not resolve 元旦")
+	}
+}
+
+// holidayFixtureYearBeforeCNY builds a T{year}c.txt covering only the
+// first few days of January, all still tagged 臘月 (month 12) as if
+// Chinese New Year hasn't happened yet. Gregorian lookups land cleanly
+// on these entries, but no entry's LunarDate is ever 正月初一, so a
+// LunarDateToDate search for that exact lunar date runs off the end of
+// the file and returns ErrNotFound, the same way it would at a real
+// data-range boundary.
+func holidayFixtureYearBeforeCNY(year, days int) string {
+	var b strings.Builder
+	b.WriteString("header\nheader\nheader\n")
+
+	date := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < days; i++ {
+		var lunarField string
+		if i == 0 {
+			lunarField = lunar.DefaultLocalizer.LunarMonth(12, false)
+		} else {
+			lunarField = lunar.DefaultLocalizer.LunarDay(i + 1)
+		}
+
+		fmt.Fprintf(&b, "%s %s 星期%s\n", date.Format("2006年1月2日"), lunarField, "一")
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return b.String()
+}
+
+// TestHolidaysDegradesOnMissingLunarFixedBoundary exercises the
+// 除夕/LastDayOfLunarYear branch of resolveLunarFixedHoliday at a
+// data-range boundary: the 2025 and 2026 fixtures never produce a
+// valid 正月初一 entry, so looking up next year's start to find 除夕's
+// eve comes back ErrNotFound, and the whole Holidays call must omit
+// 除夕 rather than error out.
+func TestHolidaysDegradesOnMissingLunarFixedBoundary(t *testing.T) {
+	ds := holidayFixtureDataSource{
+		2024: holidayFixtureYear(2024, 366, map[string]string{}),
+		2025: holidayFixtureYearBeforeCNY(2025, 10),
+		2026: holidayFixtureYearBeforeCNY(2026, 10),
+	}
+	h := lunar.New(lunar.WithDataSource(ds))
+
+	holidays, err := h.Holidays(2024, "CN")
+	if err != nil {
+		t.Fatalf("Holidays: %v", err)
+	}
+
+	for _, hol := range holidays {
+		if hol.Name == "除夕" {
+			t.Errorf("Holidays resolved 除夕 as %s despite no reachable 2025 lunar new year", hol.Date)
+		}
+	}
+}
+
+func TestIsHolidayIn(t *testing.T) {
+	ds := holidayFixtureDataSource{
+		2024: holidayFixtureYear(2024, 366, map[string]string{}),
+		2025: holidayFixtureYearBeforeCNY(2025, 10),
+		2026: holidayFixtureYearBeforeCNY(2026, 10),
+	}
+	h := lunar.New(lunar.WithDataSource(ds))
+
+	hol, ok, err := h.IsHolidayIn(lunar.NewDate(2024, 1, 1), "CN")
+	if err != nil {
+		t.Fatalf("IsHolidayIn: %v", err)
+	}
+	if !ok || hol.Name != "春節" {
+		t.Fatalf("IsHolidayIn(2024-01-01) = %v, %v, want 春節 holiday", hol, ok)
+	}
+
+	_, ok, err = h.IsHolidayIn(lunar.NewDate(2024, 1, 2), "CN")
+	if err != nil {
+		t.Fatalf("IsHolidayIn: %v", err)
+	}
+	if ok {
+		t.Fatalf("IsHolidayIn(2024-01-02) = true, want false (not a holiday)")
+	}
+}
@@ -12,12 +12,13 @@ import (
 /*
 cd ./files && curl -O https://www.hko.gov.hk/tc/gts/time/calendar/text/files/T\[1901-2100\]c.txt && \
 	find . -type f -exec sh -c 'iconv -f big5 -t utf-8 -c {} > {}.utf8' \; -exec mv "{}".utf8 "{}" \; && cd ..
+
+The fetched files live under ./files and are picked up by EmbeddedDataSource,
+so New() works out of the box once they're present. See datasource.go for
+FSDataSource and HTTPDataSource if you'd rather supply the data yourself.
 */
 
-var (
-	ErrNotFound  = errors.New("lunar: date not found")
-	loadFileFunc func(string) (io.ReadCloser, error)
-)
+var ErrNotFound = errors.New("lunar: date not found")
 
 type Result struct {
 	// Aliases    []Alias
@@ -32,6 +33,10 @@ type Date struct {
 	Year  int
 	Month int
 	Day   int
+	// Leap marks a date as falling in a leap lunar month (閏月). It is
+	// only ever set on lunar dates (e.g. Result.LunarDate); Gregorian
+	// dates always have Leap == false.
+	Leap bool
 }
 
 func NewDate(y, m, d int) Date {
@@ -97,13 +102,23 @@ type fileCache struct {
 }
 
 type Handler struct {
-	cacheMap map[int]*fileCache
+	cacheMap   map[int]*fileCache
+	dataSource DataSource
 }
 
-func New() *Handler {
-	return &Handler{
-		cacheMap: map[int]*fileCache{},
+// New builds a Handler. With no options it reads calendar data from
+// EmbeddedDataSource, so callers get a working Handler with zero
+// configuration; pass WithDataSource to read from somewhere else.
+func New(opts ...Option) *Handler {
+	h := &Handler{
+		cacheMap:   map[int]*fileCache{},
+		dataSource: EmbeddedDataSource(),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 func GetSolarTerms(year int, names ...string) ([]*Result, error) {
@@ -154,14 +169,15 @@ func (h *Handler) DateToLunarDate(d Date) (*Result, error) {
 		return r, nil
 	}
 
-	f, err := loadFileFunc(fmt.Sprintf("T%dc.txt", d.Year))
+	f, err := h.dataSource.Open(d.Year)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
 	lunarMonth := 0
-	return h.find(f, d, true, d.Year, d.Year-1, &lunarMonth)
+	lunarLeap := false
+	return h.find(f, d, true, d.Year, d.Year-1, &lunarMonth, &lunarLeap)
 }
 
 func LunarDateToDate(d Date) (*Result, error) {
@@ -177,14 +193,15 @@ func (h *Handler) LunarDateToDate(d Date) (*Result, error) {
 	}
 
 	lunarMonth := 0
+	lunarLeap := false
 	if !fileLoaded {
-		f, err := loadFileFunc(fmt.Sprintf("T%dc.txt", d.Year))
+		f, err := h.dataSource.Open(d.Year)
 		if err != nil {
 			return nil, err
 		}
 		defer f.Close()
 
-		r, err := h.find(f, d, false, d.Year, d.Year-1, &lunarMonth)
+		r, err := h.find(f, d, false, d.Year, d.Year-1, &lunarMonth, &lunarLeap)
 		if err == nil {
 			return r, nil
 		}
@@ -199,18 +216,18 @@ func (h *Handler) LunarDateToDate(d Date) (*Result, error) {
 	}
 
 	if !fileLoaded {
-		f1, err := loadFileFunc(fmt.Sprintf("T%dc.txt", d.Year+1))
+		f1, err := h.dataSource.Open(d.Year + 1)
 		if err != nil {
 			return nil, err
 		}
 		defer f1.Close()
-		return h.find(f1, d, false, d.Year+1, d.Year, &lunarMonth)
+		return h.find(f1, d, false, d.Year+1, d.Year, &lunarMonth, &lunarLeap)
 	}
 
 	return nil, ErrNotFound
 }
 
-func (h *Handler) find(rd io.Reader, d Date, dateToLunarDate bool, fileYear, lunarYear int, lunarMonth *int) (*Result, error) {
+func (h *Handler) find(rd io.Reader, d Date, dateToLunarDate bool, fileYear, lunarYear int, lunarMonth *int, lunarLeap *bool) (*Result, error) {
 	r, err := prepareReader(rd)
 	if err != nil {
 		return nil, err
@@ -227,7 +244,7 @@ func (h *Handler) find(rd io.Reader, d Date, dateToLunarDate bool, fileYear, lun
 			return nil, err
 		}
 
-		res, newunknownMonthResults, err := h.parseLine(line, fileYear, lunarYear, *lunarMonth, unknownMonthResults)
+		res, newunknownMonthResults, err := h.parseLine(line, fileYear, lunarYear, *lunarMonth, *lunarLeap, unknownMonthResults)
 		if res == nil && err == nil {
 			continue
 		}
@@ -235,7 +252,7 @@ func (h *Handler) find(rd io.Reader, d Date, dateToLunarDate bool, fileYear, lun
 		if err != nil {
 			return nil, err
 		}
-		lunarYear, *lunarMonth = res.LunarDate.Year, res.LunarDate.Month
+		lunarYear, *lunarMonth, *lunarLeap = res.LunarDate.Year, res.LunarDate.Month, res.LunarDate.Leap
 
 		if dateToLunarDate {
 			if res.Date.Equal(d) {
@@ -265,14 +282,16 @@ func (h *Handler) find(rd io.Reader, d Date, dateToLunarDate bool, fileYear, lun
 	return result, nil
 }
 
-func (h *Handler) parseLine(line string, fileYear int, lunarYear, lunarMonth int, unknownMonthResults []*Result) (*Result, []*Result, error) {
+func (h *Handler) parseLine(line string, fileYear int, lunarYear, lunarMonth int, lunarLeap bool, unknownMonthResults []*Result) (*Result, []*Result, error) {
 	fields := strings.Fields(line)
 	if len(fields) == 0 {
 		return nil, nil, nil
 	}
 
 	rs := []rune(fields[1])
+	isLeapMonth := false
 	if rs[0] == rune('閏') {
+		isLeapMonth = true
 		rs = rs[1:]
 	}
 
@@ -314,11 +333,16 @@ func (h *Handler) parseLine(line string, fileYear int, lunarYear, lunarMonth int
 
 		for _, v := range unknownMonthResults {
 			v.LunarDate.Month = tmpLunarMonth
+			v.LunarDate.Leap = lunarLeap
 			h.cache(v, fileYear)
 		}
 		newunknownMonthResults = []*Result{}
 	}
 
+	if isMonth {
+		lunarLeap = isLeapMonth
+	}
+
 	t, err := time.Parse(fileDateFormat(fileYear), fields[0])
 	if err != nil {
 		return nil, nil, fmt.Errorf("lunar: parse time error: %w", err)
@@ -327,7 +351,7 @@ func (h *Handler) parseLine(line string, fileYear int, lunarYear, lunarMonth int
 	weekday := []rune(fields[2])
 	r := &Result{
 		Date:       DateByTime(t),
-		LunarDate:  NewDate(lunarYear, lunarMonth, lunarDay),
+		LunarDate:  Date{Year: lunarYear, Month: lunarMonth, Day: lunarDay, Leap: lunarLeap},
 		WeekdayRaw: fields[2],
 		Weekday:    time.Weekday(lunarMap[weekday[len(weekday)-1]]),
 	}
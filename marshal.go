@@ -0,0 +1,129 @@
+package lunar
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	isoDateLayout     = "2006-01-02"
+	compactDateLayout = "20060102"
+)
+
+// MarshalText implements encoding.TextMarshaler, emitting d in ISO form
+// ("2024-02-10"). The zero Date marshals to an empty string.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid() {
+		return []byte{}, nil
+	}
+
+	return []byte(d.Time().Format(isoDateLayout)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting both the
+// ISO form ("2024-02-10") and the compact form ("20240210").
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	layout := isoDateLayout
+	if len(text) == len(compactDateLayout) {
+		layout = compactDateLayout
+	}
+
+	t, err := time.Parse(layout, string(text))
+	if err != nil {
+		return fmt.Errorf("lunar: parse date %q: %w", text, err)
+	}
+
+	*d = DateByTime(t)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting d as an ISO date
+// string ("2024-02-10").
+func (d Date) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting both the ISO form
+// and the compact form as a JSON string.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Date{}
+		return nil
+	}
+
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	return d.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer, storing d as an ISO date string.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid() {
+		return nil, nil
+	}
+
+	return d.Time().Format(isoDateLayout), nil
+}
+
+// Scan implements sql.Scanner, accepting a string, []byte or time.Time
+// column value.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = DateByTime(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("lunar: cannot scan %T into Date", src)
+	}
+}
+
+// lunarDateJSON adds the leap-month flag alongside the plain ISO date,
+// since Date.MarshalJSON alone can't distinguish a leap month from a
+// regular one.
+type lunarDateJSON struct {
+	Date Date `json:"date"`
+	Leap bool `json:"leap,omitempty"`
+}
+
+type resultJSON struct {
+	Date       Date          `json:"date"`
+	LunarDate  lunarDateJSON `json:"lunar_date"`
+	Weekday    int           `json:"weekday"`
+	WeekdayRaw string        `json:"weekday_raw"`
+	SolarTerm  string        `json:"solar_term,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Result) MarshalJSON() ([]byte, error) {
+	rj := resultJSON{
+		Date:       r.Date,
+		LunarDate:  lunarDateJSON{Date: r.LunarDate, Leap: r.LunarDate.Leap},
+		Weekday:    int(r.Weekday),
+		WeekdayRaw: r.WeekdayRaw,
+		SolarTerm:  r.SolarTerm,
+	}
+
+	return json.Marshal(rj)
+}
@@ -0,0 +1,206 @@
+package lunar_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	lunar "github.com/lesywix/lunar"
+)
+
+func TestDateTextRoundTrip(t *testing.T) {
+	want := lunar.NewDate(2024, 2, 10)
+
+	text, err := want.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if string(text) != "2024-02-10" {
+		t.Fatalf("MarshalText = %q, want %q", text, "2024-02-10")
+	}
+
+	var got lunar.Date
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got != want {
+		t.Fatalf("UnmarshalText(MarshalText(d)) = %v, want %v", got, want)
+	}
+
+	// The compact form accepted on input round-trips to the same Date.
+	var gotCompact lunar.Date
+	if err := gotCompact.UnmarshalText([]byte("20240210")); err != nil {
+		t.Fatalf("UnmarshalText(compact): %v", err)
+	}
+	if gotCompact != want {
+		t.Fatalf("UnmarshalText(compact) = %v, want %v", gotCompact, want)
+	}
+}
+
+func TestDateTextZeroValue(t *testing.T) {
+	var d lunar.Date
+
+	text, err := d.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if len(text) != 0 {
+		t.Fatalf("MarshalText(zero Date) = %q, want empty", text)
+	}
+
+	var got lunar.Date
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got != d {
+		t.Fatalf("UnmarshalText(MarshalText(zero)) = %v, want zero Date", got)
+	}
+}
+
+func TestDateJSONRoundTrip(t *testing.T) {
+	want := lunar.NewDate(2024, 2, 10)
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if string(data) != `"2024-02-10"` {
+		t.Fatalf("json.Marshal = %s, want %s", data, `"2024-02-10"`)
+	}
+
+	var got lunar.Date
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got != want {
+		t.Fatalf("json round-trip = %v, want %v", got, want)
+	}
+
+	// The compact form accepted on input round-trips to the same Date.
+	var gotCompact lunar.Date
+	if err := json.Unmarshal([]byte(`"20240210"`), &gotCompact); err != nil {
+		t.Fatalf("json.Unmarshal(compact): %v", err)
+	}
+	if gotCompact != want {
+		t.Fatalf("json.Unmarshal(compact) = %v, want %v", gotCompact, want)
+	}
+
+	var gotNull lunar.Date
+	if err := json.Unmarshal([]byte(`null`), &gotNull); err != nil {
+		t.Fatalf("json.Unmarshal(null): %v", err)
+	}
+	if gotNull != (lunar.Date{}) {
+		t.Fatalf("json.Unmarshal(null) = %v, want zero Date", gotNull)
+	}
+}
+
+func TestDateSQLRoundTrip(t *testing.T) {
+	want := lunar.NewDate(2024, 2, 10)
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	if _, ok := value.(driver.Value); !ok {
+		t.Fatalf("Value() did not return a driver.Value: %v", value)
+	}
+
+	var got lunar.Date
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan(string): %v", err)
+	}
+	if got != want {
+		t.Fatalf("Scan(Value()) = %v, want %v", got, want)
+	}
+
+	var gotBytes lunar.Date
+	if err := gotBytes.Scan([]byte("2024-02-10")); err != nil {
+		t.Fatalf("Scan([]byte): %v", err)
+	}
+	if gotBytes != want {
+		t.Fatalf("Scan([]byte) = %v, want %v", gotBytes, want)
+	}
+
+	var gotTime lunar.Date
+	if err := gotTime.Scan(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Scan(time.Time): %v", err)
+	}
+	if gotTime != want {
+		t.Fatalf("Scan(time.Time) = %v, want %v", gotTime, want)
+	}
+
+	var gotNil lunar.Date
+	if err := gotNil.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if gotNil != (lunar.Date{}) {
+		t.Fatalf("Scan(nil) = %v, want zero Date", gotNil)
+	}
+}
+
+func TestResultMarshalJSON(t *testing.T) {
+	r := &lunar.Result{
+		Date:       lunar.NewDate(2024, 2, 10),
+		LunarDate:  lunar.Date{Year: 2024, Month: 1, Day: 1, Leap: false},
+		Weekday:    time.Saturday,
+		WeekdayRaw: "星期六",
+		SolarTerm:  "立春",
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if got["date"] != "2024-02-10" {
+		t.Errorf("date = %v, want 2024-02-10", got["date"])
+	}
+	if got["weekday"] != float64(time.Saturday) {
+		t.Errorf("weekday = %v, want %v", got["weekday"], time.Saturday)
+	}
+	if got["weekday_raw"] != "星期六" {
+		t.Errorf("weekday_raw = %v, want 星期六", got["weekday_raw"])
+	}
+	if got["solar_term"] != "立春" {
+		t.Errorf("solar_term = %v, want 立春", got["solar_term"])
+	}
+
+	lunarDate, ok := got["lunar_date"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("lunar_date = %v, want an object", got["lunar_date"])
+	}
+	if lunarDate["date"] != "2024-01-01" {
+		t.Errorf("lunar_date.date = %v, want 2024-01-01", lunarDate["date"])
+	}
+	if _, ok := lunarDate["leap"]; ok {
+		t.Errorf("lunar_date.leap = %v, want omitted for a non-leap month", lunarDate["leap"])
+	}
+}
+
+func TestResultMarshalJSONLeap(t *testing.T) {
+	r := &lunar.Result{
+		Date:      lunar.NewDate(2023, 5, 20),
+		LunarDate: lunar.Date{Year: 2023, Month: 4, Day: 1, Leap: true},
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	lunarDate := got["lunar_date"].(map[string]interface{})
+	if lunarDate["leap"] != true {
+		t.Errorf("lunar_date.leap = %v, want true for a leap month", lunarDate["leap"])
+	}
+}
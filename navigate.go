@@ -0,0 +1,144 @@
+package lunar
+
+import (
+	"iter"
+	"sort"
+)
+
+func nameFilter(names []string) func(*Result) bool {
+	if len(names) == 0 {
+		return nil
+	}
+
+	nameMap := map[string]bool{}
+	for _, name := range names {
+		nameMap[name] = true
+	}
+
+	return func(r *Result) bool {
+		return nameMap[r.SolarTerm]
+	}
+}
+
+// solarTermsInYear returns every solar term in the Gregorian calendar
+// year, sorted chronologically, loading the year's file into cacheMap
+// if it isn't already there.
+func (h *Handler) solarTermsInYear(year int, filterFunc func(*Result) bool) ([]*Result, error) {
+	if _, ok := h.cacheMap[year]; !ok {
+		if _, err := h.DateToLunarDate(NewDate(year, 1, 1)); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []*Result
+	for _, r := range h.cacheMap[year].dateCache {
+		if r.SolarTerm == "" {
+			continue
+		}
+		if filterFunc != nil && !filterFunc(r) {
+			continue
+		}
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Date.Time().Before(results[j].Date.Time())
+	})
+
+	return results, nil
+}
+
+func (h *Handler) nextSolarTermFrom(from Date, inclusive bool, filterFunc func(*Result) bool) (*Result, error) {
+	for year := from.Year; year <= from.Year+1; year++ {
+		terms, err := h.solarTermsInYear(year, filterFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range terms {
+			if inclusive {
+				if !r.Date.Time().Before(from.Time()) {
+					return r, nil
+				}
+			} else if r.Date.Time().After(from.Time()) {
+				return r, nil
+			}
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (h *Handler) prevSolarTermBefore(before Date, inclusive bool, filterFunc func(*Result) bool) (*Result, error) {
+	for year := before.Year; year >= before.Year-1; year-- {
+		terms, err := h.solarTermsInYear(year, filterFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := len(terms) - 1; i >= 0; i-- {
+			r := terms[i]
+			if inclusive {
+				if !r.Date.Time().After(before.Time()) {
+					return r, nil
+				}
+			} else if r.Date.Time().Before(before.Time()) {
+				return r, nil
+			}
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// NextSolarTerm returns the first solar term strictly after `after`.
+// With no names it matches a term of any kind; with names it's
+// restricted to that set (e.g. "清明", "冬至").
+func NextSolarTerm(after Date, names ...string) (*Result, error) {
+	return defaultHandler.NextSolarTerm(after, names...)
+}
+
+func (h *Handler) NextSolarTerm(after Date, names ...string) (*Result, error) {
+	return h.nextSolarTermFrom(after, false, nameFilter(names))
+}
+
+// PrevSolarTerm returns the last solar term strictly before `before`.
+// With no names it matches a term of any kind; with names it's
+// restricted to that set.
+func PrevSolarTerm(before Date, names ...string) (*Result, error) {
+	return defaultHandler.PrevSolarTerm(before, names...)
+}
+
+func (h *Handler) PrevSolarTerm(before Date, names ...string) (*Result, error) {
+	return h.prevSolarTermBefore(before, false, nameFilter(names))
+}
+
+// SolarTermSeq iterates solar terms chronologically starting at from
+// (inclusive), restricted to names when given. It's a Go 1.23
+// range-over-func iterator:
+//
+//	for r := range h.SolarTermSeq(from, "清明", "冬至") { ... }
+func SolarTermSeq(from Date, names ...string) iter.Seq[*Result] {
+	return defaultHandler.SolarTermSeq(from, names...)
+}
+
+func (h *Handler) SolarTermSeq(from Date, names ...string) iter.Seq[*Result] {
+	filterFunc := nameFilter(names)
+
+	return func(yield func(*Result) bool) {
+		cur := from
+		inclusive := true
+		for {
+			r, err := h.nextSolarTermFrom(cur, inclusive, filterFunc)
+			if err != nil {
+				return
+			}
+			if !yield(r) {
+				return
+			}
+
+			cur = r.Date
+			inclusive = false
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package lunar_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	lunar "github.com/lesywix/lunar"
+)
+
+// fixtureDataSource serves synthetic T{year}c.txt content built by
+// fixtureYear, so tests can exercise year-boundary and Jan/Feb-straddle
+// navigation without real HKO data.
+type fixtureDataSource map[int]string
+
+func (f fixtureDataSource) Open(year int) (io.ReadCloser, error) {
+	content, ok := f[year]
+	if !ok {
+		return nil, fmt.Errorf("lunar_test: no fixture for year %d", year)
+	}
+
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+var fixtureWeekdayNames = map[time.Weekday]string{
+	time.Sunday:    "星期天",
+	time.Monday:    "星期一",
+	time.Tuesday:   "星期二",
+	time.Wednesday: "星期三",
+	time.Thursday:  "星期四",
+	time.Friday:    "星期五",
+	time.Saturday:  "星期六",
+}
+
+// fixtureYear builds a synthetic T{year}c.txt: a 3-line header (always
+// skipped by the parser) followed by `days` sequential days starting
+// Jan 1, with terms (keyed by "2006-01-02") assigning a solar term name
+// to the matching day. Lunar month/day text is generated with
+// DefaultLocalizer, so it round-trips through the same parser real HKO
+// files use.
+func fixtureYear(year, days int, terms map[string]string) string {
+	var b strings.Builder
+	b.WriteString("header\nheader\nheader\n")
+
+	lunarMonth, lunarDay := 1, 1
+	date := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < days; i++ {
+		var lunarField string
+		if lunarDay == 1 {
+			lunarField = lunar.DefaultLocalizer.LunarMonth(lunarMonth, false)
+		} else {
+			lunarField = lunar.DefaultLocalizer.LunarDay(lunarDay)
+		}
+
+		fmt.Fprintf(&b, "%s %s %s", date.Format("2006年1月2日"), lunarField, fixtureWeekdayNames[date.Weekday()])
+		if term, ok := terms[date.Format("2006-01-02")]; ok {
+			fmt.Fprintf(&b, " %s", term)
+		}
+		b.WriteString("\n")
+
+		date = date.AddDate(0, 0, 1)
+		lunarDay++
+		if lunarDay > 30 {
+			lunarDay = 1
+			lunarMonth++
+			if lunarMonth > 12 {
+				lunarMonth = 1
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// newFixtureHandler wires up three adjacent fixture years: 2022 and
+// 2024 each carry a single term near Jan 1, and 2023 carries the
+// 立春/雨水 pair straddling Jan/Feb, so tests can exercise navigation
+// both across a file-year boundary and within a single year.
+func newFixtureHandler() *lunar.Handler {
+	ds := fixtureDataSource{
+		2022: fixtureYear(2022, 5, map[string]string{"2022-01-05": "小寒"}),
+		2023: fixtureYear(2023, 50, map[string]string{
+			"2023-02-04": "立春",
+			"2023-02-19": "雨水",
+		}),
+		2024: fixtureYear(2024, 6, map[string]string{"2024-01-06": "小寒"}),
+	}
+
+	return lunar.New(lunar.WithDataSource(ds))
+}
+
+func TestNextPrevSolarTermCrossYearBoundary(t *testing.T) {
+	h := newFixtureHandler()
+
+	next, err := h.NextSolarTerm(lunar.NewDate(2022, 1, 5))
+	if err != nil {
+		t.Fatalf("NextSolarTerm: %v", err)
+	}
+	if next.SolarTerm != "立春" || !next.Date.Equal(lunar.NewDate(2023, 2, 4)) {
+		t.Fatalf("got %s on %s, want 立春 on 2023-02-04", next.SolarTerm, next.Date)
+	}
+
+	prev, err := h.PrevSolarTerm(lunar.NewDate(2023, 2, 4))
+	if err != nil {
+		t.Fatalf("PrevSolarTerm: %v", err)
+	}
+	if prev.SolarTerm != "小寒" || !prev.Date.Equal(lunar.NewDate(2022, 1, 5)) {
+		t.Fatalf("got %s on %s, want 小寒 on 2022-01-05", prev.SolarTerm, prev.Date)
+	}
+}
+
+func TestNextSolarTermCrossesIntoNextYearFile(t *testing.T) {
+	h := newFixtureHandler()
+
+	next, err := h.NextSolarTerm(lunar.NewDate(2023, 2, 19))
+	if err != nil {
+		t.Fatalf("NextSolarTerm: %v", err)
+	}
+	if next.SolarTerm != "小寒" || !next.Date.Equal(lunar.NewDate(2024, 1, 6)) {
+		t.Fatalf("got %s on %s, want 小寒 on 2024-01-06", next.SolarTerm, next.Date)
+	}
+}
+
+func TestNextPrevSolarTermStraddleJanFeb(t *testing.T) {
+	h := newFixtureHandler()
+
+	next, err := h.NextSolarTerm(lunar.NewDate(2023, 2, 4))
+	if err != nil {
+		t.Fatalf("NextSolarTerm: %v", err)
+	}
+	if next.SolarTerm != "雨水" || !next.Date.Equal(lunar.NewDate(2023, 2, 19)) {
+		t.Fatalf("got %s on %s, want 雨水 on 2023-02-19", next.SolarTerm, next.Date)
+	}
+
+	prev, err := h.PrevSolarTerm(lunar.NewDate(2023, 2, 19))
+	if err != nil {
+		t.Fatalf("PrevSolarTerm: %v", err)
+	}
+	if prev.SolarTerm != "立春" || !prev.Date.Equal(lunar.NewDate(2023, 2, 4)) {
+		t.Fatalf("got %s on %s, want 立春 on 2023-02-04", prev.SolarTerm, prev.Date)
+	}
+}
+
+func TestNextSolarTermFiltersByName(t *testing.T) {
+	h := newFixtureHandler()
+
+	next, err := h.NextSolarTerm(lunar.NewDate(2023, 1, 1), "雨水")
+	if err != nil {
+		t.Fatalf("NextSolarTerm: %v", err)
+	}
+	if next.SolarTerm != "雨水" || !next.Date.Equal(lunar.NewDate(2023, 2, 19)) {
+		t.Fatalf("got %s on %s, want 雨水 on 2023-02-19 (立春 should be skipped by the name filter)", next.SolarTerm, next.Date)
+	}
+}
+
+func TestSolarTermSeq(t *testing.T) {
+	h := newFixtureHandler()
+
+	var got []string
+	seq := h.SolarTermSeq(lunar.NewDate(2023, 2, 4))
+	seq(func(r *lunar.Result) bool {
+		got = append(got, r.SolarTerm)
+		return len(got) < 2
+	})
+
+	if len(got) != 2 || got[0] != "立春" || got[1] != "雨水" {
+		t.Fatalf("got %v, want [立春 雨水]", got)
+	}
+}
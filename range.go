@@ -0,0 +1,149 @@
+package lunar
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EachDate calls fn once for every Gregorian day in [from, to], in
+// chronological order, stopping at the first error fn returns.
+func EachDate(from, to Date, fn func(*Result) error) error {
+	return defaultHandler.EachDate(from, to, fn)
+}
+
+func (h *Handler) EachDate(from, to Date, fn func(*Result) error) error {
+	if to.Time().Before(from.Time()) {
+		return fmt.Errorf("lunar: invalid range %s-%s", from, to)
+	}
+
+	for t := from.Time(); !t.After(to.Time()); t = t.AddDate(0, 0, 1) {
+		r, err := h.DateToLunarDate(DateByTime(t))
+		if err != nil {
+			return err
+		}
+
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EachLunarDate calls fn once for every Gregorian day falling within the
+// lunar range [from, to], in chronological order, stopping at the first
+// error fn returns.
+func EachLunarDate(from, to Date, fn func(*Result) error) error {
+	return defaultHandler.EachLunarDate(from, to, fn)
+}
+
+func (h *Handler) EachLunarDate(from, to Date, fn func(*Result) error) error {
+	fromRes, err := h.LunarDateToDate(from)
+	if err != nil {
+		return err
+	}
+
+	toRes, err := h.LunarDateToDate(to)
+	if err != nil {
+		return err
+	}
+
+	return h.EachDate(fromRes.Date, toRes.Date, fn)
+}
+
+// SolarTermsBetween returns every solar term whose date falls within
+// [from, to], sorted chronologically.
+func SolarTermsBetween(from, to Date) ([]*Result, error) {
+	return defaultHandler.SolarTermsBetween(from, to)
+}
+
+func (h *Handler) SolarTermsBetween(from, to Date) ([]*Result, error) {
+	if to.Time().Before(from.Time()) {
+		return nil, fmt.Errorf("lunar: invalid range %s-%s", from, to)
+	}
+
+	var results []*Result
+	seen := map[Date]bool{}
+	// getSolarTerms groups by lunar year, and a Gregorian date before
+	// that lunar year's Chinese New Year still carries LunarDate.Year ==
+	// year-1, so the scan has to start one lunar year earlier than
+	// from.Year to see those terms.
+	for year := from.Year - 1; year <= to.Year; year++ {
+		terms, err := h.getSolarTerms(year, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range terms {
+			if seen[r.Date] {
+				continue
+			}
+			if r.Date.Time().Before(from.Time()) || r.Date.Time().After(to.Time()) {
+				continue
+			}
+
+			seen[r.Date] = true
+			results = append(results, r)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Date.Time().Before(results[j].Date.Time())
+	})
+
+	return results, nil
+}
+
+// MonthLunar returns every Gregorian day in the given lunar month, in
+// chronological order. A lunar month commonly spans two Gregorian
+// months.
+func MonthLunar(year, month int) ([]*Result, error) {
+	return defaultHandler.MonthLunar(year, month)
+}
+
+func (h *Handler) MonthLunar(year, month int) ([]*Result, error) {
+	start, err := h.LunarDateToDate(NewDate(year, month, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := h.nextLunarMonthStart(year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	end := DateByTime(next.Date.Time().AddDate(0, 0, -1))
+
+	var results []*Result
+	err = h.EachDate(start.Date, end, func(r *Result) error {
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// nextLunarMonthStart returns day 1 of the lunar month chronologically
+// following year/month: the leap occurrence of the same month number
+// when the year has one (e.g. 閏四月 right after 四月), otherwise
+// month+1, wrapping into the next lunar year after month 12.
+func (h *Handler) nextLunarMonthStart(year, month int) (*Result, error) {
+	leap, err := h.LunarDateToDate(Date{Year: year, Month: month, Day: 1, Leap: true})
+	if err == nil {
+		return leap, nil
+	}
+	if err != ErrNotFound {
+		return nil, err
+	}
+
+	nextYear, nextMonth := year, month+1
+	if nextMonth > 12 {
+		nextMonth = 1
+		nextYear++
+	}
+
+	return h.LunarDateToDate(NewDate(nextYear, nextMonth, 1))
+}